@@ -0,0 +1,351 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// constraintOp 表示约束条件中的比较运算符
+type constraintOp int
+
+const (
+	opEqual constraintOp = iota
+	opGreaterThan
+	opGreaterThanEqual
+	opLessThan
+	opLessThanEqual
+)
+
+// constraintClause 是一个“运算符 + 版本”对，是 AND 组中的最小单元
+type constraintClause struct {
+	op      constraintOp
+	version *SemVer
+}
+
+// matches 判断版本 v 是否满足该子句
+func (c constraintClause) matches(v *SemVer) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case opEqual:
+		return cmp == 0
+	case opGreaterThan:
+		return cmp > 0
+	case opGreaterThanEqual:
+		return cmp >= 0
+	case opLessThan:
+		return cmp < 0
+	case opLessThanEqual:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// Constraint 表示一个语义版本约束表达式，内部以 DNF（AND 组的 OR 组合）形式表示，
+// 例如 ">=1.2.3, <2.0.0 || ^3.0.0" 会被解析为两个 AND 组。
+type Constraint struct {
+	groups   [][]constraintClause
+	original string
+}
+
+// ParseConstraint 解析形如 ">=1.2.3, <2.0.0"、"~1.2.3"、"^1.2.3"、"1.2.x" 的约束表达式，
+// 多个表达式之间可以用 "||" 组合为 OR 关系。
+func ParseConstraint(constraint string) (*Constraint, error) {
+	original := constraint
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" {
+		return nil, fmt.Errorf("constraint string cannot be empty")
+	}
+
+	orParts := strings.Split(constraint, "||")
+	groups := make([][]constraintClause, 0, len(orParts))
+
+	for _, orPart := range orParts {
+		andParts := strings.Split(orPart, ",")
+		clauses := make([]constraintClause, 0, len(andParts))
+
+		for _, andPart := range andParts {
+			andPart = strings.TrimSpace(andPart)
+			if andPart == "" {
+				return nil, fmt.Errorf("invalid constraint: empty clause in %q", original)
+			}
+
+			part, err := parseClauseGroup(andPart)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, part...)
+		}
+
+		groups = append(groups, clauses)
+	}
+
+	return &Constraint{groups: groups, original: original}, nil
+}
+
+// parseClauseGroup 解析单个子表达式，可能展开为一个或多个子句（例如 "^1.2.3" 展开为上下界两个子句）
+func parseClauseGroup(expr string) ([]constraintClause, error) {
+	switch {
+	case strings.HasPrefix(expr, "~"):
+		return parseTilde(strings.TrimSpace(expr[1:]))
+	case strings.HasPrefix(expr, "^"):
+		return parseCaret(strings.TrimSpace(expr[1:]))
+	case strings.HasPrefix(expr, ">="):
+		v, _, err := parseConstraintVersion(expr[2:])
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opGreaterThanEqual, version: v}}, nil
+	case strings.HasPrefix(expr, "<="):
+		v, _, err := parseConstraintVersion(expr[2:])
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opLessThanEqual, version: v}}, nil
+	case strings.HasPrefix(expr, ">"):
+		v, _, err := parseConstraintVersion(expr[1:])
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opGreaterThan, version: v}}, nil
+	case strings.HasPrefix(expr, "<"):
+		v, _, err := parseConstraintVersion(expr[1:])
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opLessThan, version: v}}, nil
+	case strings.HasPrefix(expr, "="):
+		v, _, err := parseConstraintVersion(expr[1:])
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opEqual, version: v}}, nil
+	default:
+		if hasWildcard(expr) {
+			return parseWildcard(expr)
+		}
+		v, _, err := parseConstraintVersion(expr)
+		if err != nil {
+			return nil, err
+		}
+		return []constraintClause{{op: opEqual, version: v}}, nil
+	}
+}
+
+// hasWildcard 判断版本号中是否包含 "x"/"X"/"*" 通配符，例如 "1.2.x"
+func hasWildcard(expr string) bool {
+	for _, part := range strings.SplitN(expr, "-", 2)[:1] {
+		for _, seg := range strings.Split(part, ".") {
+			if seg == "x" || seg == "X" || seg == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseWildcard 将 "1.2.x" 这类通配符表达式展开为 [>=1.2.0, <1.3.0)
+func parseWildcard(expr string) ([]constraintClause, error) {
+	segs := strings.SplitN(expr, ".", 3)
+	for len(segs) < 3 {
+		segs = append(segs, "x")
+	}
+
+	switch {
+	case isWildcardSegment(segs[0]):
+		// "*" 匹配任意版本
+		return []constraintClause{{op: opGreaterThanEqual, version: &SemVer{}}}, nil
+	case isWildcardSegment(segs[1]):
+		major, err := strconv.ParseUint(segs[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid constraint version: %s", expr)
+		}
+		lower := &SemVer{major: major}
+		upper := &SemVer{major: major + 1}
+		return []constraintClause{
+			{op: opGreaterThanEqual, version: lower},
+			{op: opLessThan, version: upper},
+		}, nil
+	case isWildcardSegment(segs[2]):
+		major, err := strconv.ParseUint(segs[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid constraint version: %s", expr)
+		}
+		minor, err := strconv.ParseUint(segs[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid constraint version: %s", expr)
+		}
+		lower := &SemVer{major: major, minor: minor}
+		upper := &SemVer{major: major, minor: minor + 1}
+		return []constraintClause{
+			{op: opGreaterThanEqual, version: lower},
+			{op: opLessThan, version: upper},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid constraint version: %s", expr)
+	}
+}
+
+// parseTilde 解析 "~1.2.3"，允许补丁号递增：>=1.2.3, <1.3.0。
+// 只给出次版本号及以上时（如 "~1.2"）按同样方式提升次版本号；只给出主版本号时（如 "~1"）
+// 提升主版本号，例如 "~1" 等价于 ">=1.0.0 <2.0.0"。
+func parseTilde(expr string) ([]constraintClause, error) {
+	v, given, err := parseConstraintVersion(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	upper := &SemVer{major: v.major, minor: v.minor}
+	if given <= 1 {
+		upper.major++
+		upper.minor = 0
+	} else {
+		upper.minor++
+	}
+
+	return []constraintClause{
+		{op: opGreaterThanEqual, version: v},
+		{op: opLessThan, version: upper},
+	}, nil
+}
+
+// parseCaret 解析 "^1.2.3"，允许不改变最左非零位的升级：>=1.2.3, <2.0.0。
+// 当给出的版本段中最左非零位之前全是 0 时（例如 "^0.2.3"、"^0.0.3"），改为提升该非零位；
+// 如果给出的版本段全部为 0（例如 "^0"、"^0.0"），则提升输入中最后一个显式给出的段，
+// 因为更靠右的、未被给出的段应当被视为允许任意变化。
+func parseCaret(expr string) ([]constraintClause, error) {
+	v, given, err := parseConstraintVersion(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := [3]uint64{v.major, v.minor, v.patch}
+	bump := given - 1 // 默认提升最后一个显式给出的段
+	for i := 0; i < given; i++ {
+		if fields[i] > 0 {
+			bump = i
+			break
+		}
+	}
+
+	upper := &SemVer{major: v.major, minor: v.minor, patch: v.patch}
+	switch bump {
+	case 0:
+		upper.major, upper.minor, upper.patch = v.major+1, 0, 0
+	case 1:
+		upper.minor, upper.patch = v.minor+1, 0
+	default:
+		upper.patch = v.patch + 1
+	}
+
+	return []constraintClause{
+		{op: opGreaterThanEqual, version: v},
+		{op: opLessThan, version: upper},
+	}, nil
+}
+
+// isWildcardSegment 判断版本段是否是 "x"/"X"/"*" 通配符
+func isWildcardSegment(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
+
+// parseConstraintVersion 解析约束中引用的具体版本号，返回解析结果以及输入中显式给出的版本段数
+// （1~3）。缺失的次版本号/修订号补 0；通配符段（如 "^1.x" 中的 "x"）视为未给出，而非字面解析。
+// 段数会被 parseTilde/parseCaret 用来判断应当提升哪一位，因此必须在补 0 之前统计。
+func parseConstraintVersion(expr string) (*SemVer, int, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, 0, fmt.Errorf("invalid constraint version: empty")
+	}
+
+	core, suffix := expr, ""
+	if idx := strings.IndexAny(expr, "-+"); idx >= 0 {
+		core, suffix = expr[:idx], expr[idx:]
+	}
+
+	segs := strings.Split(core, ".")
+	if len(segs) > 3 {
+		return nil, 0, fmt.Errorf("invalid constraint version: %s", expr)
+	}
+
+	given := len(segs)
+	for i, s := range segs {
+		if isWildcardSegment(s) {
+			given = i
+			break
+		}
+	}
+
+	full := [3]string{"0", "0", "0"}
+	for i := 0; i < given && i < len(segs); i++ {
+		full[i] = segs[i]
+	}
+
+	if given == 0 {
+		given = 1 // 整体都是通配符（如 "*"）时按"主版本号已给出"处理，避免段数为 0
+	}
+
+	v, err := ParseSemantic(fmt.Sprintf("%s.%s.%s%s", full[0], full[1], full[2], suffix))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return v, given, nil
+}
+
+// Check 判断版本 v 是否满足该约束
+//
+// 预发布版本只有在约束本身引用了相同 MAJOR.MINOR.PATCH 的预发布版本时才会被匹配，
+// 这与 npm/Cargo 的语义一致，避免 ">=1.0.0" 意外匹配到 "2.0.0-alpha"。
+func (c *Constraint) Check(v *SemVer) bool {
+	for _, group := range c.groups {
+		if groupMatches(group, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func groupMatches(group []constraintClause, v *SemVer) bool {
+	if v.IsPrerelease() && !groupAllowsPrerelease(group, v) {
+		return false
+	}
+
+	for _, clause := range group {
+		if !clause.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupAllowsPrerelease 判断 AND 组中是否存在与 v 同一 MAJOR.MINOR.PATCH 的预发布引用
+func groupAllowsPrerelease(group []constraintClause, v *SemVer) bool {
+	for _, clause := range group {
+		cv := clause.version
+		if cv.IsPrerelease() && cv.major == v.major && cv.minor == v.minor && cv.patch == v.patch {
+			return true
+		}
+	}
+	return false
+}
+
+// String 返回约束的原始表达式
+func (c *Constraint) String() string {
+	return c.original
+}
+
+// MaxSatisfying 返回 versions 中满足约束 c 的最大版本，如果没有任何版本满足则返回 nil
+func MaxSatisfying(versions []*SemVer, c *Constraint) *SemVer {
+	var max *SemVer
+	for _, v := range versions {
+		if !c.Check(v) {
+			continue
+		}
+		if max == nil || v.GreaterThan(max) {
+			max = v
+		}
+	}
+	return max
+}
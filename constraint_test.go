@@ -0,0 +1,142 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expectError bool
+	}{
+		{name: "simple range", constraint: ">=1.2.3, <2.0.0"},
+		{name: "tilde range", constraint: "~1.2.3"},
+		{name: "caret range", constraint: "^1.2.3"},
+		{name: "wildcard minor", constraint: "1.2.x"},
+		{name: "or composed", constraint: "^1.2.3 || ^2.0.0"},
+		{name: "exact version", constraint: "1.2.3"},
+		{name: "empty", constraint: "", expectError: true},
+		{name: "empty clause", constraint: ">=1.2.3,", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, c)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{name: "range match", constraint: ">=1.2.3, <2.0.0", version: "1.5.0", expected: true},
+		{name: "range below lower bound", constraint: ">=1.2.3, <2.0.0", version: "1.2.2", expected: false},
+		{name: "range at upper bound excluded", constraint: ">=1.2.3, <2.0.0", version: "2.0.0", expected: false},
+		{name: "tilde patch upgrade", constraint: "~1.2.3", version: "1.2.9", expected: true},
+		{name: "tilde minor upgrade rejected", constraint: "~1.2.3", version: "1.3.0", expected: false},
+		{name: "caret minor upgrade", constraint: "^1.2.3", version: "1.9.0", expected: true},
+		{name: "caret major upgrade rejected", constraint: "^1.2.3", version: "2.0.0", expected: false},
+		{name: "caret zero major", constraint: "^0.2.3", version: "0.2.9", expected: true},
+		{name: "caret zero major minor bump rejected", constraint: "^0.2.3", version: "0.3.0", expected: false},
+		{name: "wildcard minor", constraint: "1.2.x", version: "1.2.9", expected: true},
+		{name: "wildcard minor rejected", constraint: "1.2.x", version: "1.3.0", expected: false},
+		{name: "or composed first branch", constraint: "^1.0.0 || ^2.0.0", version: "1.5.0", expected: true},
+		{name: "or composed second branch", constraint: "^1.0.0 || ^2.0.0", version: "2.5.0", expected: true},
+		{name: "or composed no match", constraint: "^1.0.0 || ^2.0.0", version: "3.0.0", expected: false},
+		{name: "prerelease excluded by default", constraint: ">=1.0.0", version: "2.0.0-alpha", expected: false},
+		{name: "prerelease allowed when referenced", constraint: ">=1.2.3-alpha, <1.2.3", version: "1.2.3-beta", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			assert.NoError(t, err)
+
+			v, err := ParseSemantic(tt.version)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
+
+// TestConstraint_PartialTildeCaret 覆盖仅给出部分版本段的 "~"/"^" 表达式，这类表达式的上界
+// 必须基于输入中实际给出的段数来选择提升哪一位，而不是基于补 0 之后哪一位非零（否则 "~1"、
+// "^0"、"^0.0" 这类全零段的约束会被错误地收窄为只允许 patch 号变化）。
+func TestConstraint_PartialTildeCaret(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		matches    []string
+		rejects    []string
+	}{
+		{name: "tilde major only", constraint: "~1", matches: []string{"1.0.0", "1.9.9"}, rejects: []string{"0.9.9", "2.0.0"}},
+		{name: "tilde zero major only", constraint: "~0", matches: []string{"0.0.0", "0.9.9"}, rejects: []string{"1.0.0"}},
+		{name: "caret zero major only", constraint: "^0", matches: []string{"0.0.0", "0.5.0", "0.9.9"}, rejects: []string{"1.0.0"}},
+		{name: "caret zero major zero minor", constraint: "^0.0", matches: []string{"0.0.0", "0.0.9"}, rejects: []string{"0.1.0", "1.0.0"}},
+		{name: "caret major wildcard patch", constraint: "^1.x", matches: []string{"1.0.0", "1.9.9"}, rejects: []string{"0.9.9", "2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			assert.NoError(t, err)
+
+			for _, m := range tt.matches {
+				v, err := ParseSemantic(m)
+				assert.NoError(t, err)
+				assert.True(t, c.Check(v), "%s should satisfy %s", m, tt.constraint)
+			}
+			for _, r := range tt.rejects {
+				v, err := ParseSemantic(r)
+				assert.NoError(t, err)
+				assert.False(t, c.Check(v), "%s should not satisfy %s", r, tt.constraint)
+			}
+		})
+	}
+}
+
+func TestMaxSatisfying(t *testing.T) {
+	versions := []string{"1.0.0", "1.2.3", "1.5.0", "1.9.9", "2.0.0"}
+	parsed := make([]*SemVer, 0, len(versions))
+	for _, v := range versions {
+		sv, err := ParseSemantic(v)
+		assert.NoError(t, err)
+		parsed = append(parsed, sv)
+	}
+
+	c, err := ParseConstraint("^1.2.0")
+	assert.NoError(t, err)
+
+	max := MaxSatisfying(parsed, c)
+	assert.NotNil(t, max)
+	assert.Equal(t, "1.9.9", max.String())
+}
+
+func TestMaxSatisfying_NoMatch(t *testing.T) {
+	parsed := []*SemVer{}
+	for _, v := range []string{"1.0.0", "1.2.0"} {
+		sv, err := ParseSemantic(v)
+		assert.NoError(t, err)
+		parsed = append(parsed, sv)
+	}
+
+	c, err := ParseConstraint("^2.0.0")
+	assert.NoError(t, err)
+
+	assert.Nil(t, MaxSatisfying(parsed, c))
+}
@@ -0,0 +1,121 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/gosuri/uitable"
+	"github.com/spf13/pflag"
+)
+
+// 以下变量由构建时的 ldflags 注入，参见 doc.go 中的构建示例。
+var (
+	serviceName  = "unknown"
+	gitVersion   = "v0.0.0-unknown"
+	gitCommit    = "unknown"
+	gitTreeState = "unknown"
+	gitBranch    = "unknown"
+	buildDate    = "unknown"
+)
+
+// Info 包含了完整的版本和构建信息
+type Info struct {
+	GitVersion   string `json:"gitVersion"`
+	GitCommit    string `json:"gitCommit"`
+	GitTreeState string `json:"gitTreeState"`
+	GitBranch    string `json:"gitBranch"`
+	BuildDate    string `json:"buildDate"`
+	ServiceName  string `json:"serviceName"`
+	GoVersion    string `json:"goVersion"`
+	Compiler     string `json:"compiler"`
+	Platform     string `json:"platform"`
+}
+
+// Get 返回当前运行二进制的版本信息
+func Get() Info {
+	return Info{
+		GitVersion:   gitVersion,
+		GitCommit:    gitCommit,
+		GitTreeState: gitTreeState,
+		GitBranch:    gitBranch,
+		BuildDate:    buildDate,
+		ServiceName:  serviceName,
+		GoVersion:    runtime.Version(),
+		Compiler:     runtime.Compiler,
+		Platform:     fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+}
+
+// String 返回简化的版本字符串
+func (i Info) String() string {
+	return i.GitVersion
+}
+
+// ToJSON 返回 JSON 格式的版本信息
+func (i Info) ToJSON() string {
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return fmt.Sprintf(`{"error": %q}`, err.Error())
+	}
+	return string(data)
+}
+
+// Text 返回表格格式的详细版本信息
+func (i Info) Text() string {
+	table := uitable.New()
+	table.RightAlign(0)
+	table.AddRow("GitVersion:", i.GitVersion)
+	table.AddRow("GitCommit:", i.GitCommit)
+	table.AddRow("GitTreeState:", i.GitTreeState)
+	table.AddRow("GitBranch:", i.GitBranch)
+	table.AddRow("BuildDate:", i.BuildDate)
+	table.AddRow("ServiceName:", i.ServiceName)
+	table.AddRow("GoVersion:", i.GoVersion)
+	table.AddRow("Compiler:", i.Compiler)
+	table.AddRow("Platform:", i.Platform)
+	return table.String()
+}
+
+// SetDynamicVersion 在运行时覆盖 GitVersion，version 必须是合法的语义版本
+func SetDynamicVersion(version string) error {
+	if _, err := ParseSemantic(version); err != nil {
+		return fmt.Errorf("version: invalid dynamic version %q: %w", version, err)
+	}
+	gitVersion = version
+	return nil
+}
+
+var (
+	printVersion         bool
+	checkUpdateRequested *bool
+)
+
+// CheckUpdateFeedURL 配置 --check-update 标志使用的发布源地址。
+// 调用方应在调用 AddFlags 之前设置该变量（留空时 --check-update 会报错退出）。
+var CheckUpdateFeedURL string
+
+// AddFlags 在 fs 上注册 --version 标志，以及由 NotifyUpdates 驱动的 --check-update 标志
+func AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&printVersion, "version", "V", false, "print version information and quit")
+	checkUpdateRequested = RegisterCheckUpdateFlag(fs)
+}
+
+// PrintAndExitIfRequested 处理 AddFlags 注册的标志：--version 打印版本信息后退出；
+// --check-update 同步检查一次更新并退出。两者都未指定时直接返回。
+func PrintAndExitIfRequested() {
+	if printVersion {
+		fmt.Println(Get().Text())
+		os.Exit(0)
+	}
+
+	if checkUpdateRequested != nil && *checkUpdateRequested {
+		RunCheckUpdateIfRequested(context.Background(), true, NotifyOptions{
+			ServiceName:    serviceName,
+			CurrentVersion: gitVersion,
+			FeedURL:        CheckUpdateFeedURL,
+		})
+	}
+}
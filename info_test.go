@@ -0,0 +1,65 @@
+package version
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	info := Get()
+	assert.NotEmpty(t, info.GitVersion)
+	assert.NotEmpty(t, info.GoVersion)
+	assert.Contains(t, info.Platform, "/")
+}
+
+func TestInfo_String(t *testing.T) {
+	info := Get()
+	assert.Equal(t, info.GitVersion, info.String())
+}
+
+func TestInfo_ToJSON(t *testing.T) {
+	info := Get()
+	j := info.ToJSON()
+	assert.Contains(t, j, `"gitVersion"`)
+	assert.Contains(t, j, info.GitVersion)
+}
+
+func TestInfo_Text(t *testing.T) {
+	info := Get()
+	text := info.Text()
+	assert.Contains(t, text, "GitVersion:")
+	assert.Contains(t, text, info.GitVersion)
+}
+
+func TestSetDynamicVersion(t *testing.T) {
+	original := gitVersion
+	defer func() { gitVersion = original }()
+
+	assert.NoError(t, SetDynamicVersion("1.2.3-hotfix.1"))
+	assert.Equal(t, "1.2.3-hotfix.1", Get().GitVersion)
+
+	assert.Error(t, SetDynamicVersion("not-a-version"))
+}
+
+func TestAddFlags_RegistersVersionAndCheckUpdateFlags(t *testing.T) {
+	defer func() {
+		printVersion = false
+		checkUpdateRequested = nil
+	}()
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddFlags(fs)
+
+	assert.NotNil(t, fs.Lookup("version"))
+	assert.NotNil(t, fs.Lookup(checkUpdateFlagName))
+
+	assert.NoError(t, fs.Parse([]string{"--version"}))
+	v, err := fs.GetBool("version")
+	assert.NoError(t, err)
+	assert.True(t, v)
+
+	assert.True(t, strings.Contains(fs.Lookup(checkUpdateFlagName).Usage, "release"))
+}
@@ -0,0 +1,117 @@
+package version
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsZero 判断该值是否是由空字符串、SQL NULL 或 JSON null 反序列化得到的“空版本”哨兵，
+// 区别于真实解析出来的 "0.0.0" 版本。
+func (v *SemVer) IsZero() bool {
+	return v == nil || v.isEmpty
+}
+
+// unmarshalText 是 UnmarshalJSON/UnmarshalYAML/UnmarshalText/Scan 共用的解析逻辑：
+// 空字符串被视为空版本哨兵，而不会被解析为 "0.0.0"。
+func (v *SemVer) unmarshalText(s string) error {
+	if s == "" {
+		*v = SemVer{isEmpty: true}
+		return nil
+	}
+
+	parsed, err := ParseSemantic(s)
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// MarshalJSON 将版本编码为 JSON 字符串（例如 "1.2.3"），空版本哨兵编码为 JSON null。
+func (v *SemVer) MarshalJSON() ([]byte, error) {
+	if v.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON 从 JSON 字符串解码版本；JSON null 或空字符串会被解析为空版本哨兵
+// （IsZero 返回 true），而不是 "0.0.0"。
+func (v *SemVer) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*v = SemVer{isEmpty: true}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return v.unmarshalText(s)
+}
+
+// MarshalYAML 将版本编码为 YAML 标量字符串，空版本哨兵编码为 YAML null。
+func (v *SemVer) MarshalYAML() (interface{}, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// UnmarshalYAML 从 YAML 标量解码版本；空字符串或直接传入的 null 节点会被解析为空版本哨兵。
+// 注意 yaml.v3 解码 YAML null 时不会调用本方法：SemVer 值被置为零值（IsZero 返回 false），
+// *SemVer 字段被置为 nil。
+func (v *SemVer) UnmarshalYAML(node *yaml.Node) error {
+	if node.Tag == "!!null" {
+		*v = SemVer{isEmpty: true}
+		return nil
+	}
+
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return err
+	}
+	return v.unmarshalText(s)
+}
+
+// MarshalText 实现 encoding.TextMarshaler，供 flag.TextVar 及环境变量解析器使用。
+func (v *SemVer) MarshalText() ([]byte, error) {
+	if v.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler；空文本会被解析为空版本哨兵。
+func (v *SemVer) UnmarshalText(text []byte) error {
+	return v.unmarshalText(string(text))
+}
+
+// Value 实现 database/sql/driver.Valuer，空版本哨兵写入 SQL NULL。
+func (v *SemVer) Value() (driver.Value, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan 实现 database/sql.Scanner；SQL NULL 会被解析为空版本哨兵，而不是 "0.0.0"。
+func (v *SemVer) Scan(src any) error {
+	if src == nil {
+		*v = SemVer{isEmpty: true}
+		return nil
+	}
+
+	switch s := src.(type) {
+	case string:
+		return v.unmarshalText(s)
+	case []byte:
+		return v.unmarshalText(string(s))
+	default:
+		return fmt.Errorf("version: cannot scan type %T into SemVer", src)
+	}
+}
@@ -0,0 +1,115 @@
+package version
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSemVer_JSON_RoundTrip(t *testing.T) {
+	v, err := ParseSemantic("1.2.3-alpha+build.1")
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1.2.3-alpha+build.1"`, string(data))
+
+	var decoded SemVer
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, v.Equal(&decoded))
+}
+
+func TestSemVer_JSON_NullAndEmpty(t *testing.T) {
+	var decoded SemVer
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.True(t, decoded.IsZero())
+
+	var fromEmpty SemVer
+	assert.NoError(t, json.Unmarshal([]byte(`""`), &fromEmpty))
+	assert.True(t, fromEmpty.IsZero())
+
+	data, err := json.Marshal(&fromEmpty)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestSemVer_JSON_PointerField_Null(t *testing.T) {
+	type wrapper struct {
+		Version *SemVer `json:"version"`
+	}
+
+	var w wrapper
+	assert.NoError(t, json.Unmarshal([]byte(`{"version": null}`), &w))
+	assert.Nil(t, w.Version)
+}
+
+func TestSemVer_YAML_RoundTrip(t *testing.T) {
+	v, err := ParseSemantic("2.0.0-beta")
+	assert.NoError(t, err)
+
+	data, err := yaml.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded SemVer
+	assert.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.True(t, v.Equal(&decoded))
+}
+
+func TestSemVer_YAML_Empty(t *testing.T) {
+	// yaml.v3 never calls UnmarshalYAML for a null scalar (it just leaves the Go zero
+	// value in place), so only the empty-string case is ours to handle explicitly.
+	var fromEmpty SemVer
+	assert.NoError(t, yaml.Unmarshal([]byte(`""`), &fromEmpty))
+	assert.True(t, fromEmpty.IsZero())
+}
+
+func TestSemVer_TextMarshaler(t *testing.T) {
+	v, err := ParseSemantic("1.0.0")
+	assert.NoError(t, err)
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", string(text))
+
+	var decoded SemVer
+	assert.NoError(t, decoded.UnmarshalText([]byte("1.0.0")))
+	assert.True(t, v.Equal(&decoded))
+
+	var empty SemVer
+	assert.NoError(t, empty.UnmarshalText([]byte("")))
+	assert.True(t, empty.IsZero())
+}
+
+func TestSemVer_SQL_Value(t *testing.T) {
+	v, err := ParseSemantic("1.2.3")
+	assert.NoError(t, err)
+
+	val, err := v.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", val)
+
+	var empty SemVer
+	assert.NoError(t, empty.Scan(nil))
+	val, err = empty.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
+
+func TestSemVer_SQL_Scan(t *testing.T) {
+	var v SemVer
+	assert.NoError(t, v.Scan("1.2.3"))
+	assert.Equal(t, "1.2.3", v.String())
+
+	var fromBytes SemVer
+	assert.NoError(t, fromBytes.Scan([]byte("1.2.3")))
+	assert.Equal(t, "1.2.3", fromBytes.String())
+
+	var fromNull SemVer
+	assert.NoError(t, fromNull.Scan(nil))
+	assert.True(t, fromNull.IsZero())
+
+	var invalid SemVer
+	assert.Error(t, invalid.Scan(42))
+}
@@ -0,0 +1,241 @@
+// 本文件提供一个轻量的“有新版本可用”提醒机制：与 --version 标志并列，
+// 它在程序启动时查询发布源，和当前运行的版本做比较，并在发现新版本时
+// 向 stderr 打印一行提示，同时把查询结果缓存到本地文件以避免每次运行都发起网络请求。
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+// defaultNotifyTTL 是更新检查结果缓存的默认有效期
+const defaultNotifyTTL = 24 * time.Hour
+
+// ReleaseFeed 是 NotifyUpdates 用来查询最新发布版本号的来源
+type ReleaseFeed interface {
+	// LatestVersion 返回发布源中的最新版本；includePrerelease 为 false 时应跳过预发布版本
+	LatestVersion(ctx context.Context, includePrerelease bool) (*SemVer, error)
+}
+
+// NotifyOptions 控制 NotifyUpdates 的行为
+type NotifyOptions struct {
+	// ServiceName 用于缓存目录及默认提示文案，通常与构建时注入的服务名一致
+	ServiceName string
+	// CurrentVersion 是当前运行的版本号（例如 Info.GitVersion）
+	CurrentVersion string
+	// Feed 是发布信息来源；为空时会根据 FeedURL 构造一个 HTTPReleaseFeed
+	Feed ReleaseFeed
+	// FeedURL 在 Feed 为空时使用，指向一个返回 GitHub Releases 风格 JSON 数组的地址
+	FeedURL string
+	// HTTPClient 供默认的 HTTPReleaseFeed 使用，默认 http.DefaultClient
+	HTTPClient *http.Client
+	// IncludePrerelease 控制是否将预发布版本纳入比较
+	IncludePrerelease bool
+	// TTL 是缓存结果的有效期，默认 24 小时
+	TTL time.Duration
+	// Formatter 自定义提示文案，默认输出形如 "a new version 1.2.3 is available (current: 1.0.0)"
+	Formatter func(current, latest *SemVer) string
+	// SkipVersionFile 指向一个文件，其中记录了用户选择忽略的版本号；匹配时不再提示
+	SkipVersionFile string
+	// Quiet 为 true 时完全跳过检查与提示
+	Quiet bool
+	// Writer 是提示信息的输出目标，默认 os.Stderr
+	Writer io.Writer
+	// CacheDir 覆盖缓存文件所在目录，默认 $XDG_CACHE_HOME/<ServiceName>
+	CacheDir string
+}
+
+type notifyCache struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Latest    string    `json:"latest"`
+}
+
+// NotifyUpdates 检查是否存在比 opts.CurrentVersion 更新的发布，并在发现时向 opts.Writer
+// 打印一行提示。检查结果会缓存 opts.TTL 时长，避免每次调用都发起网络请求。
+func NotifyUpdates(opts NotifyOptions) error {
+	if opts.Quiet {
+		return nil
+	}
+
+	writer := opts.Writer
+	if writer == nil {
+		writer = os.Stderr
+	}
+
+	current, err := ParseSemantic(opts.CurrentVersion)
+	if err != nil {
+		return fmt.Errorf("version: invalid current version %q: %w", opts.CurrentVersion, err)
+	}
+
+	latest, err := latestWithCache(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+	if latest == nil || !latest.GreaterThan(current) {
+		return nil
+	}
+
+	if skipped(opts.SkipVersionFile, latest) {
+		return nil
+	}
+
+	fmt.Fprintln(writer, formatNotice(opts, current, latest, writer))
+	return nil
+}
+
+// latestWithCache 返回发布源中的最新版本，优先使用未过期的本地缓存
+func latestWithCache(ctx context.Context, opts NotifyOptions) (*SemVer, error) {
+	ttl := opts.TTL
+	if ttl == 0 {
+		ttl = defaultNotifyTTL
+	}
+
+	cachePath := notifyCachePath(opts)
+
+	if cached, ok := readNotifyCache(cachePath, ttl); ok {
+		if cached.Latest == "" {
+			return nil, nil
+		}
+		return ParseSemantic(cached.Latest)
+	}
+
+	feed := opts.Feed
+	if feed == nil {
+		if opts.FeedURL == "" {
+			return nil, fmt.Errorf("version: NotifyOptions.Feed or FeedURL is required")
+		}
+		feed = &HTTPReleaseFeed{URL: opts.FeedURL, HTTPClient: opts.HTTPClient}
+	}
+
+	latest, err := feed.LatestVersion(ctx, opts.IncludePrerelease)
+	if err != nil {
+		return nil, fmt.Errorf("version: check for updates: %w", err)
+	}
+
+	writeNotifyCache(cachePath, latest)
+	return latest, nil
+}
+
+// notifyCachePath 返回缓存文件路径，默认 $XDG_CACHE_HOME/<ServiceName>/update-check.json
+func notifyCachePath(opts NotifyOptions) string {
+	if opts.CacheDir != "" {
+		return filepath.Join(opts.CacheDir, "update-check.json")
+	}
+
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		if dir, err := os.UserCacheDir(); err == nil {
+			base = dir
+		}
+	}
+	if base == "" {
+		base = os.TempDir()
+	}
+
+	serviceName := opts.ServiceName
+	if serviceName == "" {
+		serviceName = "version"
+	}
+
+	return filepath.Join(base, serviceName, "update-check.json")
+}
+
+// readNotifyCache 读取缓存文件，仅当其在 ttl 有效期内时返回 (cache, true)
+func readNotifyCache(path string, ttl time.Duration) (notifyCache, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return notifyCache{}, false
+	}
+
+	var c notifyCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return notifyCache{}, false
+	}
+
+	if time.Since(c.CheckedAt) > ttl {
+		return notifyCache{}, false
+	}
+
+	return c, true
+}
+
+// writeNotifyCache 尽力将检查结果写入缓存文件；写入失败不影响调用方（只是下次会重新查询）
+func writeNotifyCache(path string, latest *SemVer) {
+	c := notifyCache{CheckedAt: time.Now()}
+	if latest != nil {
+		c.Latest = latest.String()
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+
+	_ = os.WriteFile(path, data, 0o644)
+}
+
+// skipped 判断 skipVersionFile 中记录的版本号是否与 latest 相同
+func skipped(skipVersionFile string, latest *SemVer) bool {
+	if skipVersionFile == "" {
+		return false
+	}
+
+	data, err := os.ReadFile(skipVersionFile)
+	if err != nil {
+		return false
+	}
+
+	skipped, err := ParseSemantic(strings.TrimSpace(string(data)))
+	if err != nil {
+		return false
+	}
+
+	return skipped.Equal(latest)
+}
+
+// formatNotice 生成提示文案；未提供 Formatter 时使用默认文案，
+// 并在输出目标 writer 是终端且未设置 NO_COLOR 时加上黄色高亮。
+func formatNotice(opts NotifyOptions, current, latest *SemVer, writer io.Writer) string {
+	var msg string
+	if opts.Formatter != nil {
+		msg = opts.Formatter(current, latest)
+	} else {
+		msg = fmt.Sprintf("a new version %s is available (current: %s)", latest, current)
+	}
+
+	if !shouldColorize(writer) {
+		return msg
+	}
+	const (
+		ansiYellow = "\x1b[33m"
+		ansiReset  = "\x1b[0m"
+	)
+	return ansiYellow + msg + ansiReset
+}
+
+// shouldColorize 判断是否应该为提示信息加上 ANSI 颜色：需要 NO_COLOR 未设置，
+// 且 writer 是一个连接到终端的 *os.File。
+func shouldColorize(writer io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	f, ok := writer.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
@@ -0,0 +1,138 @@
+package version
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeFeed struct {
+	latest *SemVer
+	calls  int
+}
+
+func (f *fakeFeed) LatestVersion(ctx context.Context, includePrerelease bool) (*SemVer, error) {
+	f.calls++
+	return f.latest, nil
+}
+
+func mustParseNotify(t *testing.T, s string) *SemVer {
+	t.Helper()
+	v, err := ParseSemantic(s)
+	assert.NoError(t, err)
+	return v
+}
+
+func TestNotifyUpdates_PrintsWhenNewer(t *testing.T) {
+	var buf bytes.Buffer
+	feed := &fakeFeed{latest: mustParseNotify(t, "2.0.0")}
+
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion: "1.0.0",
+		Feed:           feed,
+		Writer:         &buf,
+		CacheDir:       t.TempDir(),
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "2.0.0")
+	assert.Contains(t, buf.String(), "1.0.0")
+}
+
+func TestNotifyUpdates_SilentWhenUpToDate(t *testing.T) {
+	var buf bytes.Buffer
+	feed := &fakeFeed{latest: mustParseNotify(t, "1.0.0")}
+
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion: "1.0.0",
+		Feed:           feed,
+		Writer:         &buf,
+		CacheDir:       t.TempDir(),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestNotifyUpdates_Quiet(t *testing.T) {
+	var buf bytes.Buffer
+	feed := &fakeFeed{latest: mustParseNotify(t, "2.0.0")}
+
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion: "1.0.0",
+		Feed:           feed,
+		Writer:         &buf,
+		Quiet:          true,
+		CacheDir:       t.TempDir(),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+	assert.Equal(t, 0, feed.calls)
+}
+
+func TestNotifyUpdates_UsesCacheWithinTTL(t *testing.T) {
+	dir := t.TempDir()
+	feed := &fakeFeed{latest: mustParseNotify(t, "2.0.0")}
+
+	opts := NotifyOptions{
+		CurrentVersion: "1.0.0",
+		Feed:           feed,
+		Writer:         &bytes.Buffer{},
+		CacheDir:       dir,
+		TTL:            time.Hour,
+	}
+
+	assert.NoError(t, NotifyUpdates(opts))
+	assert.Equal(t, 1, feed.calls)
+
+	assert.NoError(t, NotifyUpdates(opts))
+	assert.Equal(t, 1, feed.calls, "second call should be served from cache")
+}
+
+func TestNotifyUpdates_CustomFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	feed := &fakeFeed{latest: mustParseNotify(t, "2.0.0")}
+
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion: "1.0.0",
+		Feed:           feed,
+		Writer:         &buf,
+		CacheDir:       t.TempDir(),
+		Formatter: func(current, latest *SemVer) string {
+			return "update: " + current.String() + " -> " + latest.String()
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "update: 1.0.0 -> 2.0.0\n", buf.String())
+}
+
+func TestNotifyUpdates_SkipVersionFile(t *testing.T) {
+	dir := t.TempDir()
+	skipFile := filepath.Join(dir, "skip-version")
+	assert.NoError(t, os.WriteFile(skipFile, []byte("2.0.0"), 0o644))
+
+	var buf bytes.Buffer
+	feed := &fakeFeed{latest: mustParseNotify(t, "2.0.0")}
+
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion:  "1.0.0",
+		Feed:            feed,
+		Writer:          &buf,
+		CacheDir:        t.TempDir(),
+		SkipVersionFile: skipFile,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestNotifyUpdates_InvalidCurrentVersion(t *testing.T) {
+	err := NotifyUpdates(NotifyOptions{
+		CurrentVersion: "not-a-version",
+		Feed:           &fakeFeed{},
+		CacheDir:       t.TempDir(),
+	})
+	assert.Error(t, err)
+}
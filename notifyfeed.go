@@ -0,0 +1,68 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// HTTPReleaseFeed 是 ReleaseFeed 的默认实现，从一个返回 GitHub Releases 风格 JSON 数组
+// （每个元素至少包含 tag_name/prerelease/draft 字段）的 URL 中挑选出最新版本。
+type HTTPReleaseFeed struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+type releaseFeedEntry struct {
+	TagName    string `json:"tag_name"`
+	Prerelease bool   `json:"prerelease"`
+	Draft      bool   `json:"draft"`
+}
+
+// LatestVersion 实现 ReleaseFeed 接口
+func (f *HTTPReleaseFeed) LatestVersion(ctx context.Context, includePrerelease bool) (*SemVer, error) {
+	client := f.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("release feed request failed: %s", resp.Status)
+	}
+
+	var entries []releaseFeedEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decode release feed response: %w", err)
+	}
+
+	var latest *SemVer
+	for _, e := range entries {
+		if e.Draft || (e.Prerelease && !includePrerelease) {
+			continue
+		}
+
+		sv, err := ParseSemantic(e.TagName)
+		if err != nil {
+			continue
+		}
+
+		if latest == nil || sv.GreaterThan(latest) {
+			latest = sv
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,45 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleFeed = `[
+	{"tag_name": "v1.2.0", "prerelease": false, "draft": false},
+	{"tag_name": "v1.3.0-rc.1", "prerelease": true, "draft": false},
+	{"tag_name": "v1.1.0", "prerelease": false, "draft": false},
+	{"tag_name": "v1.4.0", "prerelease": false, "draft": true}
+]`
+
+func TestHTTPReleaseFeed_LatestVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(sampleFeed))
+	}))
+	defer srv.Close()
+
+	feed := &HTTPReleaseFeed{URL: srv.URL}
+
+	latest, err := feed.LatestVersion(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.0", latest.String())
+
+	latestWithPre, err := feed.LatestVersion(context.Background(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.3.0-rc.1", latestWithPre.String())
+}
+
+func TestHTTPReleaseFeed_LatestVersion_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	feed := &HTTPReleaseFeed{URL: srv.URL}
+	_, err := feed.LatestVersion(context.Background(), false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+)
+
+// checkUpdateFlagName 与 AddFlags 注册的 --version 标志并列使用
+const checkUpdateFlagName = "check-update"
+
+// RegisterCheckUpdateFlag 在 fs 上注册 --check-update 标志，返回的指针在 fs.Parse 之后
+// 指示用户是否请求了一次同步检查。AddFlags 会自动调用本函数；只有在不经由 AddFlags
+// 接入（例如只想单独提供 --check-update 而不要 --version）时才需要直接调用它。
+func RegisterCheckUpdateFlag(fs *pflag.FlagSet) *bool {
+	return fs.Bool(checkUpdateFlagName, false, "check for a newer release synchronously and exit")
+}
+
+// RunCheckUpdateIfRequested 在 requested 为 true 时强制忽略缓存、同步执行一次更新检查（仅在发现新版本时
+// 打印提示，已是最新版本时不输出任何内容），然后终止进程（状态码 0 表示检查成功，无论是否发现新版本；状态码 1 表示检查出错）。
+// requested 为 false 时直接返回，不做任何事。
+func RunCheckUpdateIfRequested(ctx context.Context, requested bool, opts NotifyOptions) {
+	if !requested {
+		return
+	}
+
+	opts.Quiet = false
+	opts.TTL = -1 // 负值使 latestWithCache 视缓存为过期，强制发起一次网络请求
+
+	if err := NotifyUpdates(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "check-update failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
@@ -0,0 +1,121 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// pseudoTimestampFormat 是 cmd/go 伪版本中使用的 UTC 时间戳格式：yyyymmddhhmmss
+const pseudoTimestampFormat = "20060102150405"
+
+// 三种伪版本形态对应的预发布标识符正则：
+//   - shape1: "yyyymmddhhmmss-abcdefabcdef"，用于找不到更早标签时，完整版本形如 vX.0.0-...
+//   - shape2: "0.yyyymmddhhmmss-abcdefabcdef"，基于一个非预发布的标签 vX.Y.Z
+//   - shape3: "<pre>.0.yyyymmddhhmmss-abcdefabcdef"，基于一个预发布标签 vX.Y.Z-<pre>
+var (
+	pseudoShape1Regex = regexp.MustCompile(`^(\d{14})-([0-9a-f]{12})$`)
+	pseudoShape2Regex = regexp.MustCompile(`^0\.(\d{14})-([0-9a-f]{12})$`)
+	pseudoShape3Regex = regexp.MustCompile(`^(.+)\.0\.(\d{14})-([0-9a-f]{12})$`)
+
+	pseudoRevisionRegex = regexp.MustCompile(`^[0-9a-f]{12}$`)
+)
+
+// detectPseudoVersion 检查 sv 的预发布标识符是否符合 cmd/go 的伪版本形态之一，
+// 如果符合则填充 isPseudo/pseudoTimestamp/pseudoRevision 字段。
+func detectPseudoVersion(sv *SemVer) {
+	if sv.prerelease == "" {
+		return
+	}
+
+	if m := pseudoShape1Regex.FindStringSubmatch(sv.prerelease); m != nil {
+		sv.isPseudo = true
+		sv.pseudoTimestamp = m[1]
+		sv.pseudoRevision = m[2]
+		return
+	}
+
+	if m := pseudoShape2Regex.FindStringSubmatch(sv.prerelease); m != nil {
+		sv.isPseudo = true
+		sv.pseudoTimestamp = m[1]
+		sv.pseudoRevision = m[2]
+		return
+	}
+
+	if m := pseudoShape3Regex.FindStringSubmatch(sv.prerelease); m != nil {
+		sv.isPseudo = true
+		sv.pseudoTimestamp = m[2]
+		sv.pseudoRevision = m[3]
+		return
+	}
+}
+
+// IsPseudo 判断该版本是否是 Go 风格的伪版本（例如 v0.0.0-20201016190024-abcdef012345）
+func (v *SemVer) IsPseudo() bool {
+	return v.isPseudo
+}
+
+// PseudoBase 返回伪版本所基于的基础版本号（去掉伪版本后缀后的部分），
+// 对于不存在更早标签的形态返回 "vX.0.0"。非伪版本调用时返回空字符串。
+func (v *SemVer) PseudoBase() string {
+	if !v.isPseudo {
+		return ""
+	}
+
+	switch {
+	case pseudoShape1Regex.MatchString(v.prerelease):
+		return fmt.Sprintf("v%d.0.0", v.major)
+	case pseudoShape2Regex.MatchString(v.prerelease):
+		return fmt.Sprintf("v%d.%d.%d", v.major, v.minor, v.patch)
+	default:
+		m := pseudoShape3Regex.FindStringSubmatch(v.prerelease)
+		return fmt.Sprintf("v%d.%d.%d-%s", v.major, v.minor, v.patch, m[1])
+	}
+}
+
+// PseudoTimestamp 返回伪版本中编码的 UTC 时间戳。非伪版本调用时返回错误。
+func (v *SemVer) PseudoTimestamp() (time.Time, error) {
+	if !v.isPseudo {
+		return time.Time{}, fmt.Errorf("version %s is not a pseudo-version", v.original)
+	}
+	return time.Parse(pseudoTimestampFormat, v.pseudoTimestamp)
+}
+
+// PseudoRevision 返回伪版本中编码的 12 位十六进制版本控制修订号。非伪版本调用时返回空字符串。
+func (v *SemVer) PseudoRevision() string {
+	return v.pseudoRevision
+}
+
+// BuildPseudoVersion 按 cmd/go 的规则构造一个伪版本：
+//   - base 为空字符串时，产出 vX.0.0-yyyymmddhhmmss-abcdefabcdef（X 取 0）
+//   - base 为不带预发布标识的版本（如 "v1.2.3"）时，产出 vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef
+//   - base 为带预发布标识的版本（如 "v1.2.3-rc.1"）时，产出 vX.Y.Z-rc.1.0.yyyymmddhhmmss-abcdefabcdef
+//
+// timestamp 会被转换为 UTC 并格式化为 14 位数字，revision 必须是 12 位十六进制字符串。
+func BuildPseudoVersion(base string, timestamp time.Time, revision string) (*SemVer, error) {
+	if !pseudoRevisionRegex.MatchString(revision) {
+		return nil, fmt.Errorf("invalid pseudo-version revision: %s (want 12 hex digits)", revision)
+	}
+
+	ts := timestamp.UTC().Format(pseudoTimestampFormat)
+
+	var versionStr string
+	if base == "" {
+		versionStr = fmt.Sprintf("v0.0.0-%s-%s", ts, revision)
+	} else {
+		baseVer, err := ParseSemantic(base)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pseudo-version base %q: %w", base, err)
+		}
+
+		if baseVer.IsPrerelease() {
+			versionStr = fmt.Sprintf("v%d.%d.%d-%s.0.%s-%s",
+				baseVer.major, baseVer.minor, baseVer.patch, baseVer.prerelease, ts, revision)
+		} else {
+			versionStr = fmt.Sprintf("v%d.%d.%d-0.%s-%s",
+				baseVer.major, baseVer.minor, baseVer.patch+1, ts, revision)
+		}
+	}
+
+	return ParseSemantic(versionStr)
+}
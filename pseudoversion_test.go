@@ -0,0 +1,148 @@
+package version
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemantic_PseudoVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		expectPseudo  bool
+		expectBase    string
+		expectRev     string
+		expectedStamp string
+	}{
+		{
+			name:          "shape1 no prior tag",
+			version:       "v0.0.0-20201016190024-abcdef012345",
+			expectPseudo:  true,
+			expectBase:    "v0.0.0",
+			expectRev:     "abcdef012345",
+			expectedStamp: "2020-10-16T19:00:24Z",
+		},
+		{
+			name:          "shape2 based on release tag",
+			version:       "v1.2.4-0.20201016190024-abcdef012345",
+			expectPseudo:  true,
+			expectBase:    "v1.2.4",
+			expectRev:     "abcdef012345",
+			expectedStamp: "2020-10-16T19:00:24Z",
+		},
+		{
+			name:          "shape3 based on prerelease tag",
+			version:       "v1.2.3-pre.0.20201016190024-abcdef012345",
+			expectPseudo:  true,
+			expectBase:    "v1.2.3-pre",
+			expectRev:     "abcdef012345",
+			expectedStamp: "2020-10-16T19:00:24Z",
+		},
+		{
+			name:         "ordinary prerelease is not pseudo",
+			version:      "v1.2.3-alpha.1",
+			expectPseudo: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ParseSemantic(tt.version)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectPseudo, v.IsPseudo())
+
+			if !tt.expectPseudo {
+				return
+			}
+
+			assert.Equal(t, tt.expectBase, v.PseudoBase())
+			assert.Equal(t, tt.expectRev, v.PseudoRevision())
+
+			ts, err := v.PseudoTimestamp()
+			assert.NoError(t, err)
+			expected, err := time.Parse(time.RFC3339, tt.expectedStamp)
+			assert.NoError(t, err)
+			assert.True(t, ts.Equal(expected))
+		})
+	}
+}
+
+func TestBuildPseudoVersion(t *testing.T) {
+	ts := time.Date(2020, 10, 16, 19, 0, 24, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		base     string
+		revision string
+		expected string
+	}{
+		{name: "no base", base: "", revision: "abcdef012345", expected: "v0.0.0-20201016190024-abcdef012345"},
+		{name: "release base", base: "v1.2.3", revision: "abcdef012345", expected: "v1.2.4-0.20201016190024-abcdef012345"},
+		{name: "prerelease base", base: "v1.2.3-pre", revision: "abcdef012345", expected: "v1.2.3-pre.0.20201016190024-abcdef012345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := BuildPseudoVersion(tt.base, ts, tt.revision)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, v.Original())
+			assert.True(t, v.IsPseudo())
+		})
+	}
+}
+
+func TestBuildPseudoVersion_InvalidRevision(t *testing.T) {
+	_, err := BuildPseudoVersion("", time.Now(), "not-hex")
+	assert.Error(t, err)
+
+	_, err = BuildPseudoVersion("", time.Now(), "abcdef01234")
+	assert.Error(t, err)
+}
+
+func TestBuildPseudoVersion_InvalidBase(t *testing.T) {
+	_, err := BuildPseudoVersion("not-a-version", time.Now(), "abcdef012345")
+	assert.Error(t, err)
+}
+
+func TestPseudoVersion_OrderingAgainstTaggedRelease(t *testing.T) {
+	pseudo, err := ParseSemantic("v1.2.4-0.20201016190024-abcdef012345")
+	assert.NoError(t, err)
+
+	tagged, err := ParseSemantic("v1.2.4")
+	assert.NoError(t, err)
+
+	// 伪版本始终是预发布版本，优先级低于同号的正式发布版本
+	assert.True(t, pseudo.LessThan(tagged))
+}
+
+func TestComparePrerelease_NumericIdentifiers(t *testing.T) {
+	v1, err := ParseSemantic("1.2.3-alpha.2")
+	assert.NoError(t, err)
+	v2, err := ParseSemantic("1.2.3-alpha.10")
+	assert.NoError(t, err)
+
+	// 数字标识符按数值比较，而非字典序（否则 "10" < "2"）
+	assert.True(t, v1.LessThan(v2))
+	assert.Equal(t, -1, v1.Compare(v2))
+}
+
+func TestComparePrerelease_NumericVsAlphanumeric(t *testing.T) {
+	numeric, err := ParseSemantic("1.2.3-1")
+	assert.NoError(t, err)
+	alnum, err := ParseSemantic("1.2.3-alpha")
+	assert.NoError(t, err)
+
+	// 数字标识符的优先级总是低于字母数字标识符
+	assert.True(t, numeric.LessThan(alnum))
+}
+
+func TestComparePrerelease_MoreFieldsHigherPrecedence(t *testing.T) {
+	shorter, err := ParseSemantic("1.2.3-alpha")
+	assert.NoError(t, err)
+	longer, err := ParseSemantic("1.2.3-alpha.1")
+	assert.NoError(t, err)
+
+	assert.True(t, shorter.LessThan(longer))
+}
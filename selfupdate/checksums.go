@@ -0,0 +1,45 @@
+package selfupdate
+
+import (
+	"bufio"
+	"strings"
+)
+
+// parseChecksumManifest 解析 `sha256sum` 风格的汇总清单，每行形如
+// "<64位十六进制摘要>  <文件名>"（文件名前也可能带有 "*" 表示二进制模式），
+// 返回文件名到摘要的映射。无法识别的行会被忽略。
+func parseChecksumManifest(data string) map[string]string {
+	result := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		sum := fields[0]
+		name := strings.TrimPrefix(fields[len(fields)-1], "*")
+		if len(sum) != 64 {
+			continue
+		}
+
+		result[name] = sum
+	}
+
+	return result
+}
+
+// firstToken 返回形如 "<hex>  <filename>" 或单独一行 "<hex>" 的校验和文件内容中的摘要部分
+func firstToken(data string) string {
+	fields := strings.Fields(data)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
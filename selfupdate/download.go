@@ -0,0 +1,28 @@
+package selfupdate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// downloadAsset 下载 url 指向的资源并返回其完整内容
+func downloadAsset(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download request failed: %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,43 @@
+package selfupdate
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+)
+
+// selfUpdateFlagName 与 version 包现有的 --version 标志并列使用
+const selfUpdateFlagName = "self-update"
+
+// RegisterUpdateFlag 在 fs 上注册 --self-update 标志，返回的指针在 fs.Parse 之后指示是否被设置。
+//
+// 本包依赖 version 包解析/比较 SemVer，因此不能反过来被 version.AddFlags/
+// PrintAndExitIfRequested 引用（会形成导入环）。调用方需要在解析标志后自行调用 RunIfRequested，
+// 就像调用 version.PrintAndExitIfRequested 处理 --version 那样，但这是两次独立的调用。
+func RegisterUpdateFlag(fs *pflag.FlagSet) *bool {
+	return fs.Bool(selfUpdateFlagName, false, "check for a newer release and replace the running binary if one is found")
+}
+
+// RunIfRequested 在 requested 为 true 时执行一次 SelfUpdate，并以状态码 0（成功，含“已是最新版本”）
+// 或 1（出错）终止进程；requested 为 false 时直接返回。
+func RunIfRequested(ctx context.Context, requested bool, opts Options) {
+	if !requested {
+		return
+	}
+
+	release, err := SelfUpdate(ctx, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "self-update failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if release == nil {
+		fmt.Fprintf(os.Stderr, "already running the latest version (%s)\n", opts.CurrentVersion)
+		os.Exit(0)
+	}
+
+	fmt.Fprintf(os.Stderr, "updated to %s; please restart to use the new version\n", release.Version)
+	os.Exit(0)
+}
@@ -0,0 +1,189 @@
+package selfupdate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kart-io/version"
+)
+
+const defaultGitHubAPIBase = "https://api.github.com"
+
+// GitHubSource 从 GitHub Releases API 拉取发布信息，是 Source 的默认实现
+type GitHubSource struct {
+	Owner, Repo string
+	HTTPClient  *http.Client
+
+	// apiBase 默认指向 GitHub 官方 API，测试中可替换为 httptest.Server 的地址
+	apiBase string
+}
+
+// NewGitHubSource 构造一个指向 owner/repo 的 GitHubSource
+func NewGitHubSource(owner, repo string) *GitHubSource {
+	return &GitHubSource{Owner: owner, Repo: repo}
+}
+
+type githubAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+type githubRelease struct {
+	TagName    string        `json:"tag_name"`
+	Body       string        `json:"body"`
+	Prerelease bool          `json:"prerelease"`
+	Draft      bool          `json:"draft"`
+	Assets     []githubAsset `json:"assets"`
+}
+
+func (s *GitHubSource) httpClient() *http.Client {
+	if s.HTTPClient != nil {
+		return s.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (s *GitHubSource) apiURL() string {
+	base := s.apiBase
+	if base == "" {
+		base = defaultGitHubAPIBase
+	}
+	return fmt.Sprintf("%s/repos/%s/%s/releases", base, s.Owner, s.Repo)
+}
+
+// Latest 实现 Source 接口，返回最新的非草稿发布（按版本号比较，而非发布时间）
+func (s *GitHubSource) Latest(ctx context.Context, includePrerelease bool) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.apiURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github releases request failed: %s", resp.Status)
+	}
+
+	var releases []githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode github releases response: %w", err)
+	}
+
+	var latest *Release
+	for _, r := range releases {
+		if r.Draft || (r.Prerelease && !includePrerelease) {
+			continue
+		}
+
+		sv, err := version.ParseSemantic(r.TagName)
+		if err != nil {
+			continue // 跳过无法解析为语义版本的标签
+		}
+
+		if latest != nil && !sv.GreaterThan(latest.Version) {
+			continue
+		}
+
+		assets, err := s.resolveAssets(ctx, r.Assets)
+		if err != nil {
+			return nil, fmt.Errorf("resolve checksums for release %s: %w", r.TagName, err)
+		}
+
+		latest = &Release{
+			Version: sv,
+			Notes:   r.Body,
+			Assets:  assets,
+		}
+	}
+
+	return latest, nil
+}
+
+// resolveAssets 将 GitHub 资源转换为 Asset，推断 GOOS/GOARCH，并尽力为每个资源填充
+// SHA256 摘要：优先使用同名的 "<asset>.sha256" 伴随文件，否则从 checksums.txt/SHA256SUMS
+// 这类汇总清单（"<hex>  <filename>" 格式，`sha256sum` 的标准输出）中查找对应条目。
+// 找不到校验和的资源会被保留但 SHA256 留空，由 Apply 拒绝安装未经校验的资源。
+func (s *GitHubSource) resolveAssets(ctx context.Context, in []githubAsset) ([]Asset, error) {
+	assets := make([]Asset, 0, len(in))
+	perAssetChecksumURL := map[string]string{}
+	var manifestURL string
+
+	for _, a := range in {
+		lower := strings.ToLower(a.Name)
+
+		// 校验和相关资源本身的文件名常常也包含平台关键字（例如
+		// "tool_linux_amd64.tar.gz.sha256"），必须先于 guessPlatform 判断，
+		// 否则会被误当作一个平台资源。
+		switch {
+		case strings.HasSuffix(lower, ".sha256"):
+			perAssetChecksumURL[a.Name[:len(a.Name)-len(".sha256")]] = a.BrowserDownloadURL
+			continue
+		case isChecksumManifest(lower):
+			manifestURL = a.BrowserDownloadURL
+			continue
+		}
+
+		if goos, goarch := guessPlatform(a.Name); goos != "" {
+			assets = append(assets, Asset{Name: a.Name, URL: a.BrowserDownloadURL, GOOS: goos, GOARCH: goarch})
+		}
+	}
+
+	var manifest map[string]string
+	if manifestURL != "" {
+		data, err := downloadAsset(ctx, s.httpClient(), manifestURL)
+		if err != nil {
+			return nil, fmt.Errorf("download checksum manifest: %w", err)
+		}
+		manifest = parseChecksumManifest(string(data))
+	}
+
+	for i := range assets {
+		if url, ok := perAssetChecksumURL[assets[i].Name]; ok {
+			data, err := downloadAsset(ctx, s.httpClient(), url)
+			if err != nil {
+				return nil, fmt.Errorf("download checksum for %s: %w", assets[i].Name, err)
+			}
+			assets[i].SHA256 = firstToken(string(data))
+			continue
+		}
+		if sum, ok := manifest[assets[i].Name]; ok {
+			assets[i].SHA256 = sum
+		}
+	}
+
+	return assets, nil
+}
+
+// isChecksumManifest 判断资源文件名是否是常见的校验和汇总清单
+func isChecksumManifest(lowerName string) bool {
+	return strings.Contains(lowerName, "checksums") || strings.Contains(lowerName, "sha256sums")
+}
+
+var knownGOOS = []string{"linux", "darwin", "windows", "freebsd"}
+var knownGOARCH = []string{"amd64", "arm64", "386", "arm"}
+
+// guessPlatform 从资源文件名中推断 GOOS/GOARCH，例如 "tool_linux_amd64.tar.gz"
+func guessPlatform(name string) (goos, goarch string) {
+	lower := strings.ToLower(name)
+	for _, o := range knownGOOS {
+		if strings.Contains(lower, o) {
+			goos = o
+			break
+		}
+	}
+	for _, a := range knownGOARCH {
+		if strings.Contains(lower, a) {
+			goarch = a
+			break
+		}
+	}
+	return goos, goarch
+}
@@ -0,0 +1,127 @@
+package selfupdate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleReleases = `[
+	{"tag_name": "v1.2.0", "prerelease": false, "draft": false, "assets": [
+		{"name": "tool_linux_amd64.tar.gz", "browser_download_url": "https://example.com/v1.2.0/tool_linux_amd64.tar.gz"}
+	]},
+	{"tag_name": "v1.3.0-rc.1", "prerelease": true, "draft": false, "assets": []},
+	{"tag_name": "v1.1.0", "prerelease": false, "draft": false, "assets": []},
+	{"tag_name": "not-a-semver", "prerelease": false, "draft": false, "assets": []},
+	{"tag_name": "v1.4.0", "prerelease": false, "draft": true, "assets": []}
+]`
+
+func newTestServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestGitHubSource_Latest(t *testing.T) {
+	srv := newTestServer(t, sampleReleases)
+	defer srv.Close()
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+
+	release, err := src.Latest(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NotNil(t, release)
+	assert.Equal(t, "1.2.0", release.Version.String())
+	assert.Len(t, release.Assets, 1)
+	assert.Equal(t, "linux", release.Assets[0].GOOS)
+	assert.Equal(t, "amd64", release.Assets[0].GOARCH)
+}
+
+func TestGitHubSource_Latest_IncludePrerelease(t *testing.T) {
+	srv := newTestServer(t, sampleReleases)
+	defer srv.Close()
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+
+	release, err := src.Latest(context.Background(), true)
+	assert.NoError(t, err)
+	assert.NotNil(t, release)
+	assert.Equal(t, "1.3.0-rc.1", release.Version.String())
+}
+
+func TestGitHubSource_Latest_ResolvesChecksumFromManifest(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef  tool_linux_amd64.tar.gz\n"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	releases := `[
+		{"tag_name": "v1.2.0", "prerelease": false, "draft": false, "assets": [
+			{"name": "tool_linux_amd64.tar.gz", "browser_download_url": "` + srv.URL + `/tool_linux_amd64.tar.gz"},
+			{"name": "checksums.txt", "browser_download_url": "` + srv.URL + `/checksums.txt"}
+		]}
+	]`
+	mux.HandleFunc("/repos/kart-io/version/releases", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(releases))
+	})
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+	release, err := src.Latest(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Len(t, release.Assets, 1)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef", release.Assets[0].SHA256)
+}
+
+func TestGitHubSource_Latest_ResolvesChecksumFromPerAssetFile(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tool_linux_amd64.tar.gz.sha256", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("cafebabecafebabecafebabecafebabecafebabecafebabecafebabecafebabe  tool_linux_amd64.tar.gz\n"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	releases := `[
+		{"tag_name": "v1.2.0", "prerelease": false, "draft": false, "assets": [
+			{"name": "tool_linux_amd64.tar.gz", "browser_download_url": "` + srv.URL + `/tool_linux_amd64.tar.gz"},
+			{"name": "tool_linux_amd64.tar.gz.sha256", "browser_download_url": "` + srv.URL + `/tool_linux_amd64.tar.gz.sha256"}
+		]}
+	]`
+	mux.HandleFunc("/repos/kart-io/version/releases", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(releases))
+	})
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+	release, err := src.Latest(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Len(t, release.Assets, 1)
+	assert.Equal(t, "cafebabecafebabecafebabecafebabecafebabecafebabecafebabecafebabe", release.Assets[0].SHA256)
+}
+
+func TestGitHubSource_Latest_NoChecksumLeavesSHA256Empty(t *testing.T) {
+	srv := newTestServer(t, sampleReleases)
+	defer srv.Close()
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+	release, err := src.Latest(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Len(t, release.Assets, 1)
+	assert.Empty(t, release.Assets[0].SHA256)
+}
+
+func TestGitHubSource_Latest_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	src := &GitHubSource{Owner: "kart-io", Repo: "version", apiBase: srv.URL}
+	_, err := src.Latest(context.Background(), false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package selfupdate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const oldSuffix = ".old"
+
+// ReplaceSelf 原子地将当前运行的可执行文件替换为 data 的内容：先在同一目录下写入临时文件并
+// fsync，再将当前文件移动为 ".old" 备份，最后将临时文件 rename 到原路径。
+// 失败时调用 RestoreOld 可以把 ".old" 备份恢复为当前可执行文件。
+func ReplaceSelf(data []byte) error {
+	target, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+	return replaceAt(target, data)
+}
+
+// currentExecutable 解析当前运行的可执行文件路径，并展开符号链接
+func currentExecutable() (string, error) {
+	target, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("resolve current executable: %w", err)
+	}
+	target, err = filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", fmt.Errorf("resolve executable symlink: %w", err)
+	}
+	return target, nil
+}
+
+// replaceAt 是 ReplaceSelf 的核心逻辑，以 target 路径为参数而非直接读取 os.Executable，
+// 使其可以在测试中针对临时文件驱动，而不必替换测试二进制本身。
+func replaceAt(target string, data []byte) error {
+	info, err := os.Stat(target)
+	if err != nil {
+		return fmt.Errorf("stat current executable: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), filepath.Base(target)+".new-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	replaced := false
+	defer func() {
+		// 替换成功后临时文件要么已被 rename 走，要么（Windows 重启后移动）必须保留到重启，因此只在失败时删除
+		if !replaced {
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("fsync temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+	if err := os.Chmod(tmpPath, info.Mode()); err != nil {
+		return fmt.Errorf("chmod temp file: %w", err)
+	}
+
+	oldPath := target + oldSuffix
+	os.Remove(oldPath) // 丢弃上一轮遗留的备份
+	if err := os.Rename(target, oldPath); err != nil {
+		return fmt.Errorf("back up current executable: %w", err)
+	}
+
+	if err := atomicReplace(tmpPath, target); err != nil {
+		// 尽力恢复原可执行文件，让进程仍然可用
+		_ = os.Rename(oldPath, target)
+		return err
+	}
+
+	replaced = true
+	return nil
+}
+
+// RestoreOld 将 ReplaceSelf 保留的 ".old" 备份恢复为当前可执行文件，用于更新后健康检查失败时回滚。
+// 这是一个库原语：本包不会自动调用它，调用方需要在健康检查失败后自行触发回滚。
+func RestoreOld() error {
+	target, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+	return restoreAt(target)
+}
+
+// restoreAt 是 RestoreOld 的核心逻辑，以 target 路径为参数，原因同 replaceAt。
+func restoreAt(target string) error {
+	oldPath := target + oldSuffix
+	if _, err := os.Stat(oldPath); err != nil {
+		return fmt.Errorf("no backup to restore: %w", err)
+	}
+
+	return atomicReplace(oldPath, target)
+}
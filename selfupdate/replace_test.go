@@ -0,0 +1,82 @@
+package selfupdate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceAt_Success(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "app")
+	assert.NoError(t, os.WriteFile(target, []byte("old contents"), 0o744))
+
+	assert.NoError(t, replaceAt(target, []byte("new contents")))
+
+	got, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "new contents", string(got))
+}
+
+func TestReplaceAt_PreservesMode(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "app")
+	assert.NoError(t, os.WriteFile(target, []byte("old contents"), 0o700))
+
+	assert.NoError(t, replaceAt(target, []byte("new contents")))
+
+	info, err := os.Stat(target)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+}
+
+func TestReplaceAt_BackupRestorableViaRestoreAt(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "app")
+	assert.NoError(t, os.WriteFile(target, []byte("old contents"), 0o744))
+
+	assert.NoError(t, replaceAt(target, []byte("new contents")))
+
+	backup, err := os.ReadFile(target + oldSuffix)
+	assert.NoError(t, err)
+	assert.Equal(t, "old contents", string(backup))
+
+	assert.NoError(t, restoreAt(target))
+
+	restored, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "old contents", string(restored))
+}
+
+// TestReplaceAt_BackupFailureLeavesOriginalUntouched 通过预先把 ".old" 备份路径占用为一个
+// 非空目录，让备份阶段的 os.Rename 必然失败（无法用文件覆盖非空目录），即使测试以 root 身份
+// 运行也是如此（root 同样无法 rename 到非空目录）。这模拟了写入临时文件之后、正式替换之前
+// 发生的失败，断言此时原可执行文件完全未被改动。
+func TestReplaceAt_BackupFailureLeavesOriginalUntouched(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "app")
+	assert.NoError(t, os.WriteFile(target, []byte("old contents"), 0o744))
+
+	oldPath := target + oldSuffix
+	assert.NoError(t, os.Mkdir(oldPath, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(oldPath, "keep.txt"), []byte("x"), 0o644))
+
+	err := replaceAt(target, []byte("new contents"))
+	assert.Error(t, err)
+
+	got, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "old contents", string(got))
+}
+
+func TestRestoreAt_NoBackup(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "app")
+	assert.NoError(t, os.WriteFile(target, []byte("contents"), 0o744))
+
+	err := restoreAt(target)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no backup to restore")
+}
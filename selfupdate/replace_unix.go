@@ -0,0 +1,10 @@
+//go:build !windows
+
+package selfupdate
+
+import "os"
+
+// atomicReplace 在类 Unix 系统上同目录内的 rename 是原子的
+func atomicReplace(src, dst string) error {
+	return os.Rename(src, dst)
+}
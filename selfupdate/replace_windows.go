@@ -0,0 +1,34 @@
+//go:build windows
+
+package selfupdate
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// atomicReplace 在 Windows 上尝试直接 rename；如果目标文件正被当前进程锁定（典型情况，
+// 因为自身正在运行），则退化为“重启后移动”策略：MOVEFILE_DELAY_UNTIL_REBOOT 会在下次
+// 重启时由系统完成替换。
+func atomicReplace(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	srcPtr, err := windows.UTF16PtrFromString(src)
+	if err != nil {
+		return fmt.Errorf("convert src path: %w", err)
+	}
+	dstPtr, err := windows.UTF16PtrFromString(dst)
+	if err != nil {
+		return fmt.Errorf("convert dst path: %w", err)
+	}
+
+	flags := uint32(windows.MOVEFILE_REPLACE_EXISTING | windows.MOVEFILE_DELAY_UNTIL_REBOOT)
+	if err := windows.MoveFileEx(srcPtr, dstPtr, flags); err != nil {
+		return fmt.Errorf("schedule move-on-reboot: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Package selfupdate 基于 version 包的 SemVer 比较能力，实现对运行中二进制文件的自我更新：
+// 检查发布源中是否存在更新的版本、下载匹配当前平台的资源、校验完整性与签名，
+// 并通过“写临时文件 + fsync + rename”的方式原子地替换当前可执行文件。
+package selfupdate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"net/http"
+	"runtime"
+
+	"github.com/kart-io/version"
+)
+
+// Asset 描述一次发布中针对某个平台/架构的可下载文件
+type Asset struct {
+	Name      string // 资源文件名
+	URL       string // 下载地址
+	GOOS      string
+	GOARCH    string
+	SHA256    string // 十六进制编码的 SHA256 摘要，用于完整性校验
+	Signature string // 可选，十六进制编码的 ed25519 签名，对 SHA256 摘要签名
+}
+
+// Release 表示一次可供自更新使用的发布
+type Release struct {
+	Version *version.SemVer
+	Notes   string
+	Assets  []Asset
+}
+
+// AssetFor 返回 Release 中匹配指定 goos/goarch 的资源，不存在时返回 nil
+func (r *Release) AssetFor(goos, goarch string) *Asset {
+	for i := range r.Assets {
+		if r.Assets[i].GOOS == goos && r.Assets[i].GOARCH == goarch {
+			return &r.Assets[i]
+		}
+	}
+	return nil
+}
+
+// Source 是拉取发布信息的来源，默认实现见 GitHubSource
+type Source interface {
+	// Latest 返回来源中最新的发布；includePrerelease 为 false 时应跳过预发布版本
+	Latest(ctx context.Context, includePrerelease bool) (*Release, error)
+}
+
+// Options 控制自更新的行为
+type Options struct {
+	// CurrentVersion 是当前运行二进制的版本号（例如调用方可传入 version.Get().GitVersion）
+	CurrentVersion string
+	// Source 是发布信息来源，默认可使用 NewGitHubSource 构造
+	Source Source
+	// IncludePrerelease 控制是否将预发布版本纳入比较
+	IncludePrerelease bool
+	// GOOS/GOARCH 默认为 runtime.GOOS/runtime.GOARCH，可覆盖用于测试
+	GOOS, GOARCH string
+	// PublicKey 可选，提供后 Apply 会校验资源的 ed25519 签名
+	PublicKey ed25519.PublicKey
+	// HTTPClient 用于下载资源，默认使用 http.DefaultClient
+	HTTPClient *http.Client
+}
+
+func (o Options) goos() string {
+	if o.GOOS != "" {
+		return o.GOOS
+	}
+	return runtime.GOOS
+}
+
+func (o Options) goarch() string {
+	if o.GOARCH != "" {
+		return o.GOARCH
+	}
+	return runtime.GOARCH
+}
+
+func (o Options) httpClient() *http.Client {
+	if o.HTTPClient != nil {
+		return o.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// CheckLatest 查询 opts.Source 中最新的发布，如果比 opts.CurrentVersion 新则返回该发布，
+// 否则返回 (nil, nil)。
+func CheckLatest(ctx context.Context, opts Options) (*Release, error) {
+	if opts.Source == nil {
+		return nil, fmt.Errorf("selfupdate: Options.Source is required")
+	}
+
+	current, err := version.ParseSemantic(opts.CurrentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("selfupdate: invalid current version %q: %w", opts.CurrentVersion, err)
+	}
+
+	release, err := opts.Source.Latest(ctx, opts.IncludePrerelease)
+	if err != nil {
+		return nil, fmt.Errorf("selfupdate: fetch latest release: %w", err)
+	}
+	if release == nil || release.Version == nil {
+		return nil, nil
+	}
+
+	if !release.Version.GreaterThan(current) {
+		return nil, nil
+	}
+	return release, nil
+}
+
+// Apply 下载 release 中匹配当前平台的资源，校验其 SHA256（以及可选的 ed25519 签名），
+// 并原子地替换当前可执行文件。之前的二进制会保留为 ".old"；回滚不是自动的——调用方需要在
+// 替换后自行判断是否需要回滚（例如更新后的健康检查失败时），并显式调用 RestoreOld。
+func Apply(ctx context.Context, release *Release, opts Options) error {
+	asset := release.AssetFor(opts.goos(), opts.goarch())
+	if asset == nil {
+		return fmt.Errorf("selfupdate: no asset for %s/%s in release %s", opts.goos(), opts.goarch(), release.Version)
+	}
+
+	if asset.SHA256 == "" {
+		return fmt.Errorf("selfupdate: asset %s has no published SHA256 checksum; refusing to install an unverified binary", asset.Name)
+	}
+
+	data, err := downloadAsset(ctx, opts.httpClient(), asset.URL)
+	if err != nil {
+		return fmt.Errorf("selfupdate: download asset %s: %w", asset.Name, err)
+	}
+
+	if err := VerifySHA256(data, asset.SHA256); err != nil {
+		return fmt.Errorf("selfupdate: verify checksum for %s: %w", asset.Name, err)
+	}
+
+	if opts.PublicKey != nil {
+		if asset.Signature == "" {
+			return fmt.Errorf("selfupdate: asset %s has no signature to verify against configured public key", asset.Name)
+		}
+		if err := VerifySignature(data, asset.Signature, opts.PublicKey); err != nil {
+			return fmt.Errorf("selfupdate: verify signature for %s: %w", asset.Name, err)
+		}
+	}
+
+	if err := ReplaceSelf(data); err != nil {
+		return fmt.Errorf("selfupdate: replace running binary: %w", err)
+	}
+
+	return nil
+}
+
+// SelfUpdate 是 CheckLatest 和 Apply 的便捷组合：如果存在更新则下载并应用，返回被应用的发布；
+// 如果已是最新版本则返回 (nil, nil)。与 Apply 一样，失败后的回滚（RestoreOld）需要调用方自行触发。
+func SelfUpdate(ctx context.Context, opts Options) (*Release, error) {
+	release, err := CheckLatest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if release == nil {
+		return nil, nil
+	}
+
+	if err := Apply(ctx, release, opts); err != nil {
+		return nil, err
+	}
+	return release, nil
+}
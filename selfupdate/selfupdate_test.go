@@ -0,0 +1,147 @@
+package selfupdate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kart-io/version"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSource struct {
+	release *Release
+	err     error
+}
+
+func (f *fakeSource) Latest(ctx context.Context, includePrerelease bool) (*Release, error) {
+	return f.release, f.err
+}
+
+func mustParse(t *testing.T, s string) *version.SemVer {
+	t.Helper()
+	v, err := version.ParseSemantic(s)
+	assert.NoError(t, err)
+	return v
+}
+
+func TestCheckLatest(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		latest         string
+		expectUpdate   bool
+	}{
+		{name: "newer available", currentVersion: "1.0.0", latest: "1.1.0", expectUpdate: true},
+		{name: "already latest", currentVersion: "1.1.0", latest: "1.1.0", expectUpdate: false},
+		{name: "current ahead of feed", currentVersion: "2.0.0", latest: "1.1.0", expectUpdate: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &fakeSource{release: &Release{Version: mustParse(t, tt.latest)}}
+			release, err := CheckLatest(context.Background(), Options{
+				CurrentVersion: tt.currentVersion,
+				Source:         src,
+			})
+			assert.NoError(t, err)
+			if tt.expectUpdate {
+				assert.NotNil(t, release)
+				assert.Equal(t, tt.latest, release.Version.String())
+			} else {
+				assert.Nil(t, release)
+			}
+		})
+	}
+}
+
+func TestCheckLatest_InvalidCurrentVersion(t *testing.T) {
+	src := &fakeSource{release: &Release{Version: mustParse(t, "1.0.0")}}
+	_, err := CheckLatest(context.Background(), Options{CurrentVersion: "not-a-version", Source: src})
+	assert.Error(t, err)
+}
+
+func TestCheckLatest_MissingSource(t *testing.T) {
+	_, err := CheckLatest(context.Background(), Options{CurrentVersion: "1.0.0"})
+	assert.Error(t, err)
+}
+
+func TestRelease_AssetFor(t *testing.T) {
+	r := &Release{
+		Version: mustParse(t, "1.0.0"),
+		Assets: []Asset{
+			{Name: "tool_linux_amd64", GOOS: "linux", GOARCH: "amd64"},
+			{Name: "tool_darwin_arm64", GOOS: "darwin", GOARCH: "arm64"},
+		},
+	}
+
+	asset := r.AssetFor("linux", "amd64")
+	assert.NotNil(t, asset)
+	assert.Equal(t, "tool_linux_amd64", asset.Name)
+
+	assert.Nil(t, r.AssetFor("windows", "amd64"))
+}
+
+func TestApply_RefusesMissingChecksum(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("binary contents"))
+	}))
+	defer srv.Close()
+
+	release := &Release{
+		Version: mustParse(t, "1.1.0"),
+		Assets: []Asset{
+			{Name: "tool_linux_amd64", URL: srv.URL, GOOS: "linux", GOARCH: "amd64"},
+		},
+	}
+
+	err := Apply(context.Background(), release, Options{GOOS: "linux", GOARCH: "amd64"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no published SHA256 checksum")
+}
+
+func TestApply_RefusesMismatchedChecksum(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("binary contents"))
+	}))
+	defer srv.Close()
+
+	wrongSum := sha256.Sum256([]byte("not the actual contents"))
+
+	release := &Release{
+		Version: mustParse(t, "1.1.0"),
+		Assets: []Asset{
+			{Name: "tool_linux_amd64", URL: srv.URL, SHA256: hex.EncodeToString(wrongSum[:]), GOOS: "linux", GOARCH: "amd64"},
+		},
+	}
+
+	err := Apply(context.Background(), release, Options{GOOS: "linux", GOARCH: "amd64"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "verify checksum")
+}
+
+func TestSelfUpdate_RefusesMissingChecksum(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("binary contents"))
+	}))
+	defer srv.Close()
+
+	src := &fakeSource{release: &Release{
+		Version: mustParse(t, "1.1.0"),
+		Assets: []Asset{
+			{Name: "tool_linux_amd64", URL: srv.URL, GOOS: "linux", GOARCH: "amd64"},
+		},
+	}}
+
+	_, err := SelfUpdate(context.Background(), Options{
+		CurrentVersion: "1.0.0",
+		Source:         src,
+		GOOS:           "linux",
+		GOARCH:         "amd64",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no published SHA256 checksum")
+}
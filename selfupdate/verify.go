@@ -0,0 +1,48 @@
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// VerifySHA256 校验 data 的 SHA256 摘要是否与十六进制编码的 expectedHex 一致
+func VerifySHA256(data []byte, expectedHex string) error {
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return fmt.Errorf("decode expected sha256: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if !equalBytes(sum[:], expected) {
+		return fmt.Errorf("sha256 mismatch: got %x, want %s", sum, expectedHex)
+	}
+	return nil
+}
+
+// VerifySignature 使用 pubKey 校验 data 的 SHA256 摘要上的十六进制编码 ed25519 签名
+func VerifySignature(data []byte, signatureHex string, pubKey ed25519.PublicKey) error {
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return fmt.Errorf("decode signature: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if !ed25519.Verify(pubKey, sum[:], signature) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
+
+func equalBytes(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySHA256(t *testing.T) {
+	data := []byte("hello selfupdate")
+	sum := sha256.Sum256(data)
+	expected := hex.EncodeToString(sum[:])
+
+	assert.NoError(t, VerifySHA256(data, expected))
+	assert.Error(t, VerifySHA256(data, hex.EncodeToString(make([]byte, 32))))
+	assert.Error(t, VerifySHA256(data, "not-hex"))
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	data := []byte("hello selfupdate")
+	sum := sha256.Sum256(data)
+	signature := ed25519.Sign(priv, sum[:])
+	sigHex := hex.EncodeToString(signature)
+
+	assert.NoError(t, VerifySignature(data, sigHex, pub))
+
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	assert.Error(t, VerifySignature(data, sigHex, otherPub))
+
+	assert.Error(t, VerifySignature(data, "not-hex", pub))
+}
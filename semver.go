@@ -15,6 +15,15 @@ type SemVer struct {
 	prerelease string
 	metadata   string
 	original   string
+
+	// pseudo 相关字段仅在该版本是 Go 风格伪版本时有效，参见 pseudoversion.go
+	isPseudo        bool
+	pseudoTimestamp string
+	pseudoRevision  string
+
+	// isEmpty 标记该值是由空字符串/SQL NULL/JSON null 反序列化而来的“空版本”哨兵，
+	// 与真实的 "0.0.0" 版本区分开，参见 marshal.go
+	isEmpty bool
 }
 
 // 语义版本正则表达式，遵循 semver 2.0.0 规范
@@ -53,14 +62,17 @@ func ParseSemantic(version string) (*SemVer, error) {
 		return nil, fmt.Errorf("invalid patch version: %s", matches[3])
 	}
 
-	return &SemVer{
+	sv := &SemVer{
 		major:      major,
 		minor:      minor,
 		patch:      patch,
 		prerelease: matches[4], // 预发布版本（可选）
 		metadata:   matches[5], // 元数据（可选）
 		original:   version,
-	}, nil
+	}
+	detectPseudoVersion(sv)
+
+	return sv, nil
 }
 
 // Major 返回主版本号
@@ -139,13 +151,54 @@ func (v *SemVer) Compare(other *SemVer) int {
 	} else if v.prerelease != "" && other.prerelease == "" {
 		return -1
 	} else if v.prerelease != "" && other.prerelease != "" {
-		return strings.Compare(v.prerelease, other.prerelease)
+		return comparePrerelease(v.prerelease, other.prerelease)
 	}
 
 	// 版本号完全相同
 	return 0
 }
 
+// comparePrerelease 按 semver 2.0.0 规范比较两个预发布标识符：
+// 逐个按 "." 分隔的标识符从左到右比较，纯数字标识符按数值比较，
+// 其余按 ASCII 字典序比较，数字标识符的优先级总是低于字母数字标识符，
+// 当前缀全部相同时标识符更多的一方优先级更高。
+func comparePrerelease(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		ai, aErr := strconv.ParseUint(aParts[i], 10, 64)
+		bi, bErr := strconv.ParseUint(bParts[i], 10, 64)
+		aNumeric := aErr == nil
+		bNumeric := bErr == nil
+
+		switch {
+		case aNumeric && bNumeric:
+			if ai != bi {
+				if ai < bi {
+					return -1
+				}
+				return 1
+			}
+		case aNumeric && !bNumeric:
+			return -1
+		case !aNumeric && bNumeric:
+			return 1
+		default:
+			if cmp := strings.Compare(aParts[i], bParts[i]); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+
+	if len(aParts) < len(bParts) {
+		return -1
+	} else if len(aParts) > len(bParts) {
+		return 1
+	}
+	return 0
+}
+
 // Equal 判断两个版本是否相等
 func (v *SemVer) Equal(other *SemVer) bool {
 	return v.Compare(other) == 0